@@ -0,0 +1,10 @@
+// Package artifact provides the Artifact type, representing a single file
+// produced during the build/archive pipes and later published by release
+// clients.
+package artifact
+
+// Artifact represents an artifact and its relevant info.
+type Artifact struct {
+	Name string
+	Path string
+}
@@ -0,0 +1,39 @@
+// Package client provides clients used to interact with source code hosting
+// services (GitHub, Gitea, GitLab, ...) during the release pipe.
+package client
+
+import (
+	"os"
+
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+// Repo represents any kind of repo (github, gitlab, etc), be it a release
+// repo, a brew tap, etc.
+type Repo struct {
+	Owner  string
+	Name   string
+	Branch string
+}
+
+// Client is an interface that needs to be implemented for each release
+// target (GitHub, Gitea, GitLab, etc).
+type Client interface {
+	CreateFile(
+		ctx *context.Context,
+		commitAuthor config.CommitAuthor,
+		repo Repo,
+		content []byte,
+		path,
+		message string,
+	) error
+	CreateRelease(ctx *context.Context, body string) (releaseID string, err error)
+	Upload(
+		ctx *context.Context,
+		releaseID string,
+		artifact *artifact.Artifact,
+		file *os.File,
+	) error
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/pkg/client/gitea/release"
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+	"github.com/goreleaser/goreleaser/pkg/tmpl"
+)
+
+// giteaClient is a Client implementation that talks to a Gitea instance.
+type giteaClient struct {
+	client *gitea.Client
+}
+
+// NewGiteaReleaseClient returns a Client configured to talk to the Gitea
+// instance pointed at by release.gitea_urls.api. The underlying HTTP
+// transport is customized from release.gitea when the user configured
+// insecure_skip_verify, a private CA, mTLS client certificate, proxy, or
+// retry policy, so
+// self-hosted instances behind those don't need to be reached through
+// global process-wide settings.
+func NewGiteaReleaseClient(ctx *context.Context, token string) (Client, error) {
+	instanceURL, err := getInstanceURL(ctx.Config.Release.GiteaURLs.API)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := newGiteaHTTPClient(ctx.Config.Release.Gitea)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []gitea.ClientOption{gitea.SetToken(token)}
+	if httpClient != nil {
+		opts = append(opts, gitea.SetHTTPClient(httpClient))
+	}
+
+	sdkClient, err := gitea.NewClient(instanceURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitea client: %w", err)
+	}
+
+	return &giteaClient{client: sdkClient}, nil
+}
+
+// getInstanceURL extracts the scheme+host of a Gitea API URL, since the SDK
+// expects the instance root rather than the `/api/v1` base path.
+func getInstanceURL(apiURL string) (string, error) {
+	parsed, err := url.Parse(apiURL)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("invalid gitea instance url: %q", apiURL)
+	}
+	return fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host), nil
+}
+
+// CreateFile doesn't really make sense in the Gitea scenario, this is a
+// simple implementation to fulfill the interface.
+func (c *giteaClient) CreateFile(
+	ctx *context.Context,
+	commitAuthor config.CommitAuthor,
+	repo Repo,
+	content []byte,
+	path,
+	message string,
+) error {
+	return nil
+}
+
+// CreateRelease creates a new release or updates an existing one matching
+// the current tag, returning the release ID as a string. The actual API
+// calls are delegated to pkg/client/gitea/release; this method only
+// builds the release.Options from ctx.
+func (c *giteaClient) CreateRelease(ctx *context.Context, body string) (string, error) {
+	title, err := tmpl.New(ctx).Apply(ctx.Config.Release.NameTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	opts := release.Options{
+		Owner:      ctx.Config.Release.Gitea.Owner,
+		Repo:       ctx.Config.Release.Gitea.Name,
+		Tag:        ctx.Git.CurrentTag,
+		Target:     ctx.Git.Commit,
+		Name:       title,
+		Note:       body,
+		Draft:      ctx.Config.Release.Draft,
+		Prerelease: ctx.PreRelease,
+	}
+
+	svc := release.New(c.client)
+
+	existing, err := svc.Find(opts.Owner, opts.Repo, opts.Tag)
+	if err != nil {
+		return "", err
+	}
+
+	if existing != nil {
+		rel, err := svc.Update(opts, existing.ID)
+		if err != nil {
+			return "", err
+		}
+		rememberReleaseInfo(ctx, rel, body)
+		return fmt.Sprint(rel.ID), nil
+	}
+
+	rel, err := svc.Create(opts)
+	if err != nil {
+		return "", err
+	}
+	rememberReleaseInfo(ctx, rel, body)
+	return fmt.Sprint(rel.ID), nil
+}
+
+// rememberReleaseInfo sets ctx.ReleaseURL and ctx.ReleaseNotes from the
+// created/updated release, so later pipes (e.g. announcers) can refer to it.
+func rememberReleaseInfo(ctx *context.Context, rel *gitea.Release, body string) {
+	ctx.ReleaseURL = rel.HTMLURL
+	ctx.ReleaseNotes = body
+}
+
+// Upload uploads an artifact as a release attachment. When
+// release.gitea.replace_existing_artifacts is enabled (the default),
+// any existing attachment with the same name is deleted first, so
+// re-running goreleaser against an existing release doesn't pile up
+// duplicate assets.
+func (c *giteaClient) Upload(
+	ctx *context.Context,
+	releaseID string,
+	artifact *artifact.Artifact,
+	file *os.File,
+) error {
+	id, err := strconv.ParseInt(releaseID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	owner := ctx.Config.Release.Gitea.Owner
+	repoName := ctx.Config.Release.Gitea.Name
+	svc := release.New(c.client)
+
+	if replaceExistingArtifacts(ctx.Config.Release.Gitea) {
+		if err := c.deleteMatchingAttachment(svc, owner, repoName, id, artifact.Name); err != nil {
+			return err
+		}
+	}
+
+	_, err = svc.UploadAsset(owner, repoName, id, artifact.Name, file)
+	return err
+}
+
+// replaceExistingArtifacts defaults to true, matching user expectations
+// that re-running a release replaces assets rather than duplicating them.
+func replaceExistingArtifacts(cfg config.Gitea) bool {
+	if cfg.ReplaceExistingArtifacts == nil {
+		return true
+	}
+	return *cfg.ReplaceExistingArtifacts
+}
+
+// deleteMatchingAttachment removes the release attachment named name, if
+// one already exists.
+func (c *giteaClient) deleteMatchingAttachment(svc release.Service, owner, repo string, releaseID int64, name string) error {
+	attachments, err := svc.ListAssets(owner, repo, releaseID)
+	if err != nil {
+		return err
+	}
+	for _, a := range attachments {
+		if a.Name == name {
+			return svc.DeleteAsset(owner, repo, releaseID, a.ID)
+		}
+	}
+	return nil
+}
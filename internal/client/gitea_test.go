@@ -97,9 +97,11 @@ func (s *GiteaReleasesTestSuite) SetupTest() {
 			ProjectName: "project",
 			Release: config.Release{
 				NameTemplate: "{{ .ProjectName }}_{{ .Version }}",
-				Gitea: config.Repo{
-					Owner: s.owner,
-					Name:  s.repoName,
+				Gitea: config.Gitea{
+					Repo: config.Repo{
+						Owner: s.owner,
+						Name:  s.repoName,
+					},
 				},
 				Draft: s.isDraft,
 			},
@@ -120,128 +122,16 @@ func (s *GiteaReleasesTestSuite) SetupTest() {
 	}
 	s.releaseID = 666
 	s.releaseURL = fmt.Sprintf("%v/%v", s.releasesURL, s.releaseID)
-	s.client = &giteaClient{client: gitea.NewClient(s.url, "")}
+	httpmock.RegisterResponder("GET", s.url+"/api/v1/version", httpmock.NewStringResponder(200, `{"version":"1.16.0"}`))
+	sdkClient, err := gitea.NewClient(s.url)
+	require.NoError(s.T(), err)
+	s.client = &giteaClient{client: sdkClient}
 }
 
 func (s *GiteaReleasesTestSuite) TearDownTest() {
 	httpmock.DeactivateAndReset()
 }
 
-type GetExistingReleaseSuite struct {
-	GiteaReleasesTestSuite
-}
-
-func (s *GetExistingReleaseSuite) TestNoReleases() {
-	t := s.T()
-	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(200, "[]"))
-
-	release, err := s.client.getExistingRelease(s.owner, s.repoName, s.tag)
-	assert.Nil(t, release)
-	assert.NoError(t, err)
-}
-
-func (s *GetExistingReleaseSuite) TestNoRepo() {
-	t := s.T()
-	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(404, ""))
-
-	release, err := s.client.getExistingRelease(s.owner, s.repoName, s.tag)
-	assert.Nil(t, release)
-	assert.Error(t, err)
-}
-
-func (s *GetExistingReleaseSuite) TestReleaseExists() {
-	t := s.T()
-	release := gitea.Release{TagName: s.tag}
-	resp, err := httpmock.NewJsonResponder(200, []gitea.Release{release})
-	require.NoError(t, err)
-	httpmock.RegisterResponder("GET", s.releasesURL, resp)
-
-	result, err := s.client.getExistingRelease(s.owner, s.repoName, s.tag)
-	assert.NotNil(t, result)
-	assert.Equal(t, *result, release)
-	assert.NoError(t, err)
-
-}
-
-func TestGetExistingReleaseSuite(t *testing.T) {
-	suite.Run(t, new(GetExistingReleaseSuite))
-}
-
-type GiteacreateReleaseSuite struct {
-	GiteaReleasesTestSuite
-}
-
-func (s *GiteacreateReleaseSuite) TestSuccess() {
-	t := s.T()
-	expectedRelease := gitea.Release{
-		TagName:      s.tag,
-		Target:       s.commit,
-		Note:         s.description,
-		IsDraft:      s.isDraft,
-		IsPrerelease: s.isPrerelease,
-	}
-	resp, err := httpmock.NewJsonResponder(200, &expectedRelease)
-	require.NoError(t, err)
-	httpmock.RegisterResponder("POST", s.releasesURL, resp)
-
-	release, err := s.client.createRelease(s.ctx, s.title, s.description)
-	assert.NoError(t, err)
-	assert.NotNil(t, release)
-	assert.Equal(t, expectedRelease, *release)
-}
-
-func (s *GiteacreateReleaseSuite) TestError() {
-	t := s.T()
-	httpmock.RegisterResponder("POST", s.releasesURL, httpmock.NewStringResponder(400, ""))
-
-	release, err := s.client.createRelease(s.ctx, s.title, s.description)
-	assert.Error(t, err)
-	assert.Nil(t, release)
-}
-
-func TestGiteacreateReleaseSuite(t *testing.T) {
-	suite.Run(t, new(GiteacreateReleaseSuite))
-}
-
-type GiteaupdateReleaseSuite struct {
-	GiteaReleasesTestSuite
-}
-
-func (s *GiteaupdateReleaseSuite) SetupTest() {
-	s.GiteaReleasesTestSuite.SetupTest()
-}
-
-func (s *GiteaupdateReleaseSuite) TestSuccess() {
-	t := s.T()
-	expectedRelease := gitea.Release{
-		TagName:      s.tag,
-		Target:       s.commit,
-		Note:         s.description,
-		IsDraft:      s.isDraft,
-		IsPrerelease: s.isPrerelease,
-	}
-	resp, err := httpmock.NewJsonResponder(200, &expectedRelease)
-	require.NoError(t, err)
-	httpmock.RegisterResponder("PATCH", s.releaseURL, resp)
-
-	release, err := s.client.updateRelease(s.ctx, s.title, s.description, s.releaseID)
-	assert.NoError(t, err)
-	assert.NotNil(t, release)
-}
-
-func (s *GiteaupdateReleaseSuite) TestError() {
-	t := s.T()
-	httpmock.RegisterResponder("PATCH", s.releaseURL, httpmock.NewStringResponder(400, ""))
-
-	release, err := s.client.updateRelease(s.ctx, s.title, s.description, s.releaseID)
-	assert.Error(t, err)
-	assert.Nil(t, release)
-}
-
-func TestGiteaupdateReleaseSuite(t *testing.T) {
-	suite.Run(t, new(GiteaupdateReleaseSuite))
-}
-
 func TestGiteaCreateFile(t *testing.T) {
 	client := giteaClient{}
 	ctx := context.Context{}
@@ -267,80 +157,9 @@ func (s *GiteaCreateReleaseSuite) TestTemplateError() {
 	assert.Error(t, err)
 }
 
-func (s *GiteaCreateReleaseSuite) TestErrorGettingExisitngRelease() {
-	t := s.T()
-	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(404, ""))
-
-	releaseID, err := s.client.CreateRelease(s.ctx, s.description)
-	assert.Empty(t, releaseID)
-	assert.Error(t, err)
-}
-
-func (s *GiteaCreateReleaseSuite) TestErrorUpdatingRelease() {
-	t := s.T()
-	expectedRelease := gitea.Release{TagName: s.tag}
-	resp, err := httpmock.NewJsonResponder(200, []gitea.Release{expectedRelease})
-	require.NoError(t, err)
-	httpmock.RegisterResponder("GET", s.releasesURL, resp)
-	httpmock.RegisterResponder("PATCH", s.releaseURL, httpmock.NewStringResponder(400, ""))
-
-	releaseID, err := s.client.CreateRelease(s.ctx, s.description)
-	assert.Empty(t, releaseID)
-	assert.Error(t, err)
-}
-
-func (s *GiteaCreateReleaseSuite) TestSuccessUpdatingRelease() {
-	t := s.T()
-	expectedRelease := gitea.Release{
-		ID:           666,
-		TagName:      s.tag,
-		Target:       s.commit,
-		Note:         s.description,
-		IsDraft:      s.isDraft,
-		IsPrerelease: s.isPrerelease,
-	}
-	resp, err := httpmock.NewJsonResponder(200, []gitea.Release{expectedRelease})
-	require.NoError(t, err)
-	httpmock.RegisterResponder("GET", s.releasesURL, resp)
-	resp, err = httpmock.NewJsonResponder(200, &expectedRelease)
-	require.NoError(t, err)
-	httpmock.RegisterResponder("PATCH", s.releaseURL, resp)
-
-	newDescription := "NewDescription"
-	releaseID, err := s.client.CreateRelease(s.ctx, newDescription)
-	assert.Equal(t, fmt.Sprint(expectedRelease.ID), releaseID)
-	assert.NoError(t, err)
-}
-
-func (s *GiteaCreateReleaseSuite) TestErrorCreatingRelease() {
-	t := s.T()
-	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(200, "[]"))
-	httpmock.RegisterResponder("POST", s.releasesURL, httpmock.NewStringResponder(400, ""))
-
-	releaseID, err := s.client.CreateRelease(s.ctx, s.description)
-	assert.Empty(t, releaseID)
-	assert.Error(t, err)
-}
-
-func (s *GiteaCreateReleaseSuite) TestSuccessCreatingRelease() {
-	t := s.T()
-	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(200, "[]"))
-	expectedRelease := gitea.Release{
-		ID:           666,
-		TagName:      s.tag,
-		Target:       s.commit,
-		Note:         s.description,
-		IsDraft:      s.isDraft,
-		IsPrerelease: s.isPrerelease,
-	}
-	resp, err := httpmock.NewJsonResponder(200, &expectedRelease)
-	require.NoError(t, err)
-	httpmock.RegisterResponder("POST", s.releasesURL, resp)
-
-	releaseID, err := s.client.CreateRelease(s.ctx, s.description)
-	assert.Equal(t, fmt.Sprint(expectedRelease.ID), releaseID)
-	assert.NoError(t, err)
-}
+// The success/error paths of Find/Create/Update themselves are covered by
+// pkg/client/gitea/release's own ReleaseSuite; this suite only exercises
+// behavior specific to the giteaClient adapter.
 
 func TestGiteaCreateReleaseSuite(t *testing.T) {
 	suite.Run(t, new(GiteaCreateReleaseSuite))
@@ -362,6 +181,7 @@ func (s *GiteaUploadSuite) SetupTest() {
 	require.NotNil(t, file)
 	s.file = file
 	s.releaseAttachmentsURL = fmt.Sprintf("%v/assets", s.releaseURL)
+	httpmock.RegisterResponder("GET", s.releaseAttachmentsURL, httpmock.NewStringResponder(200, "[]"))
 }
 
 func (s *GiteaUploadSuite) TearDownTest() {
@@ -396,6 +216,45 @@ func (s *GiteaUploadSuite) TestSuccess() {
 	assert.NoError(t, err)
 }
 
+func (s *GiteaUploadSuite) TestReplacesExistingAttachment() {
+	t := s.T()
+	existing := gitea.Attachment{ID: 42, Name: s.artifact.Name}
+	resp, err := httpmock.NewJsonResponder(200, []gitea.Attachment{existing})
+	require.NoError(t, err)
+	httpmock.RegisterResponder("GET", s.releaseAttachmentsURL, resp)
+	deleteURL := fmt.Sprintf("%v/%v", s.releaseAttachmentsURL, existing.ID)
+	httpmock.RegisterResponder("DELETE", deleteURL, httpmock.NewStringResponder(204, ""))
+	attachment := gitea.Attachment{}
+	resp, err = httpmock.NewJsonResponder(200, &attachment)
+	require.NoError(t, err)
+	httpmock.RegisterResponder("POST", s.releaseAttachmentsURL, resp)
+
+	err = s.client.Upload(s.ctx, fmt.Sprint(s.releaseID), s.artifact, s.file)
+	assert.NoError(t, err)
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["DELETE "+deleteURL])
+}
+
+func (s *GiteaUploadSuite) TestDoesNotReplaceWhenDisabled() {
+	t := s.T()
+	disabled := false
+	s.ctx.Config.Release.Gitea.ReplaceExistingArtifacts = &disabled
+	existing := gitea.Attachment{ID: 42, Name: s.artifact.Name}
+	resp, err := httpmock.NewJsonResponder(200, []gitea.Attachment{existing})
+	require.NoError(t, err)
+	httpmock.RegisterResponder("GET", s.releaseAttachmentsURL, resp)
+	attachment := gitea.Attachment{}
+	resp, err = httpmock.NewJsonResponder(200, &attachment)
+	require.NoError(t, err)
+	httpmock.RegisterResponder("POST", s.releaseAttachmentsURL, resp)
+
+	err = s.client.Upload(s.ctx, fmt.Sprint(s.releaseID), s.artifact, s.file)
+	assert.NoError(t, err)
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 0, info["GET "+s.releaseAttachmentsURL])
+	assert.Equal(t, 1, info["POST "+s.releaseAttachmentsURL])
+}
+
 func TestGiteaUploadSuite(t *testing.T) {
 	suite.Run(t, new(GiteaUploadSuite))
 }
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/goreleaser/goreleaser/pkg/config"
+)
+
+// defaultGiteaRetryMaxAttempts is used by a retryingRoundTripper built with
+// a non-positive maxAttempts. newGiteaHTTPClient only installs one for a
+// positive release.gitea.retry.max_attempts; a zero value disables retries.
+const defaultGiteaRetryMaxAttempts = 3
+
+// newGiteaHTTPClient builds the *http.Client used to talk to the Gitea
+// instance from the release.gitea settings, honoring insecure_skip_verify,
+// custom CAs, mTLS client certificates, an HTTP(S) proxy and retries on transient
+// errors. It returns nil, nil when none of those are configured, so the
+// SDK's default transport is left untouched.
+func newGiteaHTTPClient(cfg config.Gitea) (*http.Client, error) {
+	if !usesCustomTransport(cfg) {
+		return nil, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := buildGiteaTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid release.gitea.proxy_url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var rt http.RoundTripper = transport
+	if cfg.Retry.MaxAttempts > 0 {
+		rt = &retryingRoundTripper{
+			next:        transport,
+			maxAttempts: cfg.Retry.MaxAttempts,
+			backoff:     cfg.Retry.Backoff,
+		}
+	}
+
+	return &http.Client{Transport: rt}, nil
+}
+
+func usesCustomTransport(cfg config.Gitea) bool {
+	return cfg.InsecureSkipVerify ||
+		cfg.CACert != "" ||
+		cfg.ClientCert != "" ||
+		cfg.ProxyURL != "" ||
+		cfg.Retry.MaxAttempts > 0
+}
+
+func buildGiteaTLSConfig(cfg config.Gitea) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if cfg.CACert != "" {
+		pem, err := os.ReadFile(cfg.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read release.gitea.ca_cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to parse release.gitea.ca_cert: %s", cfg.CACert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load release.gitea.client_cert/client_key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// retryingRoundTripper retries requests that fail with a 5xx or 429
+// response, using a simple exponential backoff.
+type retryingRoundTripper struct {
+	next        http.RoundTripper
+	maxAttempts int
+	backoff     time.Duration
+}
+
+func (r *retryingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxAttempts := r.maxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultGiteaRetryMaxAttempts
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			// http.Transport drains req.Body on send, so a retried request
+			// needs a fresh body reader each attempt. This is only
+			// checked once a retry is actually happening, so requests
+			// with a streaming, non-rewindable body (e.g. an asset
+			// upload reading straight from an *os.File) still succeed
+			// on the first, common-case attempt.
+			if req.Body != nil && req.GetBody == nil {
+				return nil, fmt.Errorf("gitea: cannot retry request to %s: no GetBody to rewind the request body", req.URL)
+			}
+			time.Sleep(r.backoff * time.Duration(1<<(attempt-1)))
+			if req.GetBody != nil {
+				body, bodyErr := req.GetBody()
+				if bodyErr != nil {
+					return nil, fmt.Errorf("gitea: failed to rewind request body for retry: %w", bodyErr)
+				}
+				req.Body = body
+			}
+		}
+
+		resp, err = r.next.RoundTrip(req)
+		if err != nil {
+			continue
+		}
+		if !shouldRetry(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt < maxAttempts-1 {
+			resp.Body.Close()
+		}
+	}
+	return resp, err
+}
+
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsesCustomTransport(t *testing.T) {
+	assert.False(t, usesCustomTransport(config.Gitea{}))
+	assert.True(t, usesCustomTransport(config.Gitea{InsecureSkipVerify: true}))
+	assert.True(t, usesCustomTransport(config.Gitea{CACert: "ca.pem"}))
+	assert.True(t, usesCustomTransport(config.Gitea{ClientCert: "cert.pem"}))
+	assert.True(t, usesCustomTransport(config.Gitea{ProxyURL: "http://proxy.example.com"}))
+	assert.True(t, usesCustomTransport(config.Gitea{Retry: config.GiteaRetry{MaxAttempts: 3}}))
+}
+
+func TestNewGiteaHTTPClientNoop(t *testing.T) {
+	httpClient, err := newGiteaHTTPClient(config.Gitea{})
+	require.NoError(t, err)
+	assert.Nil(t, httpClient)
+}
+
+func TestNewGiteaHTTPClientInvalidProxyURL(t *testing.T) {
+	_, err := newGiteaHTTPClient(config.Gitea{ProxyURL: "://nope"})
+	assert.Error(t, err)
+}
+
+func TestBuildGiteaTLSConfig(t *testing.T) {
+	t.Run("insecure skip verify", func(t *testing.T) {
+		tlsConfig, err := buildGiteaTLSConfig(config.Gitea{InsecureSkipVerify: true})
+		require.NoError(t, err)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+	})
+
+	t.Run("invalid ca cert path", func(t *testing.T) {
+		_, err := buildGiteaTLSConfig(config.Gitea{CACert: "/no/such/file.pem"})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid client cert pair", func(t *testing.T) {
+		_, err := buildGiteaTLSConfig(config.Gitea{ClientCert: "/no/such/cert.pem", ClientKey: "/no/such/key.pem"})
+		assert.Error(t, err)
+	})
+}
+
+type failNTimesRoundTripper struct {
+	fail  int
+	calls int
+}
+
+func (f *failNTimesRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	status := http.StatusOK
+	if f.calls <= f.fail {
+		status = http.StatusServiceUnavailable
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Header:     http.Header{},
+	}, nil
+}
+
+func TestRetryingRoundTripperRetriesUntilSuccess(t *testing.T) {
+	next := &failNTimesRoundTripper{fail: 2}
+	rt := &retryingRoundTripper{next: next, maxAttempts: 3, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", bytes.NewBufferString("payload"))
+	require.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, next.calls)
+}
+
+func TestRetryingRoundTripperGivesUpAfterMaxAttempts(t *testing.T) {
+	next := &failNTimesRoundTripper{fail: 10}
+	rt := &retryingRoundTripper{next: next, maxAttempts: 2, backoff: time.Millisecond}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", bytes.NewBufferString("payload"))
+	require.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 2, next.calls)
+}
+
+func TestRetryingRoundTripperFirstAttemptDoesNotRequireGetBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rt := &retryingRoundTripper{next: http.DefaultTransport, maxAttempts: 3, backoff: time.Millisecond}
+
+	// A streaming body (no GetBody, unlike *bytes.Buffer/*bytes.Reader) must
+	// still succeed on the first attempt: only an actual retry needs to
+	// rewind the body.
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = pw.Write([]byte("payload"))
+		pw.Close()
+	}()
+	req, err := http.NewRequest(http.MethodPost, server.URL, pr)
+	require.NoError(t, err)
+	req.GetBody = nil
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestRetryingRoundTripperFailsRetryWithoutGetBody(t *testing.T) {
+	next := &failNTimesRoundTripper{fail: 10}
+	rt := &retryingRoundTripper{next: next, maxAttempts: 3, backoff: time.Millisecond}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = pw.Write([]byte("payload"))
+		pw.Close()
+	}()
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", pr)
+	require.NoError(t, err)
+	req.GetBody = nil
+
+	_, err = rt.RoundTrip(req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no GetBody")
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestShouldRetry(t *testing.T) {
+	assert.True(t, shouldRetry(http.StatusTooManyRequests))
+	assert.True(t, shouldRetry(http.StatusInternalServerError))
+	assert.True(t, shouldRetry(http.StatusBadGateway))
+	assert.False(t, shouldRetry(http.StatusOK))
+	assert.False(t, shouldRetry(http.StatusBadRequest))
+}
@@ -0,0 +1,109 @@
+// Package matrix announces releases to a Matrix room, following the
+// payload shape used by Gitea/Forgejo's built-in Matrix webhook.
+package matrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/goreleaser/goreleaser/pkg/context"
+	"github.com/goreleaser/goreleaser/pkg/tmpl"
+)
+
+const defaultMessageTemplate = "{{ .ProjectName }} {{ .Tag }} is out! Check it out at {{ .ReleaseURL }}"
+
+// Pipe for Matrix.
+type Pipe struct{}
+
+func (Pipe) String() string { return "matrix" }
+
+// Skip if the room isn't configured.
+func (Pipe) Skip(ctx *context.Context) bool {
+	cfg := ctx.Config.Announce.Matrix
+	return cfg.HomeserverURL == "" || cfg.RoomID == ""
+}
+
+// Default sets the config defaults.
+func (Pipe) Default(ctx *context.Context) error {
+	cfg := &ctx.Config.Announce.Matrix
+	if cfg.MsgType == "" {
+		cfg.MsgType = "m.notice"
+	}
+	if cfg.MessageTemplate == "" {
+		cfg.MessageTemplate = defaultMessageTemplate
+	}
+	return nil
+}
+
+// Announce posts the rendered message_template to the configured Matrix room.
+func (Pipe) Announce(ctx *context.Context) error {
+	cfg := ctx.Config.Announce.Matrix
+
+	token := os.Getenv(cfg.AccessTokenEnv)
+	if token == "" {
+		return fmt.Errorf("matrix: missing access token: %q is not set", cfg.AccessTokenEnv)
+	}
+
+	body, err := tmpl.New(ctx).Apply(cfg.MessageTemplate)
+	if err != nil {
+		return fmt.Errorf("matrix: failed to render message: %w", err)
+	}
+
+	// formatted_body is rendered by Matrix clients as HTML, so the plain
+	// body needs escaping rather than being reused verbatim.
+	payload, err := json.Marshal(map[string]string{
+		"msgtype":        cfg.MsgType,
+		"body":           body,
+		"format":         "org.matrix.custom.html",
+		"formatted_body": html.EscapeString(body),
+	})
+	if err != nil {
+		return fmt.Errorf("matrix: failed to marshal message: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, sendURL(cfg.HomeserverURL, cfg.RoomID, ctx), bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("matrix: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("matrix: failed to send message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("matrix: failed to send message: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// sendURL builds the room send endpoint with a transaction id derived from
+// the release being announced, so repeated calls for the same project/tag
+// (a manual re-run, a future retry wrapper, ...) reuse the same id rather
+// than posting a duplicate message.
+func sendURL(homeserverURL, roomID string, ctx *context.Context) string {
+	return fmt.Sprintf(
+		"%s/_matrix/client/v3/rooms/%s/send/m.room.message/%d",
+		strings.TrimSuffix(homeserverURL, "/"),
+		url.PathEscape(roomID),
+		releaseTxnID(ctx),
+	)
+}
+
+// releaseTxnID deterministically hashes the project name and tag, so the
+// same release always produces the same transaction id.
+func releaseTxnID(ctx *context.Context) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s", ctx.Config.ProjectName, ctx.Git.CurrentTag)
+	return h.Sum64()
+}
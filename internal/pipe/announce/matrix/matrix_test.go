@@ -0,0 +1,187 @@
+package matrix
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringer(t *testing.T) {
+	require.Equal(t, "matrix", Pipe{}.String())
+}
+
+func TestSkip(t *testing.T) {
+	t.Run("skip", func(t *testing.T) {
+		require.True(t, Pipe{}.Skip(context.New(config.Project{})))
+	})
+
+	t.Run("skip no room", func(t *testing.T) {
+		ctx := context.New(config.Project{
+			Announce: config.Announce{
+				Matrix: config.Matrix{HomeserverURL: "https://matrix.example.com"},
+			},
+		})
+		require.True(t, Pipe{}.Skip(ctx))
+	})
+
+	t.Run("dont skip", func(t *testing.T) {
+		ctx := context.New(config.Project{
+			Announce: config.Announce{
+				Matrix: config.Matrix{
+					HomeserverURL: "https://matrix.example.com",
+					RoomID:        "!room:example.com",
+				},
+			},
+		})
+		require.False(t, Pipe{}.Skip(ctx))
+	})
+}
+
+func TestDefault(t *testing.T) {
+	ctx := context.New(config.Project{})
+	require.NoError(t, Pipe{}.Default(ctx))
+	require.Equal(t, "m.notice", ctx.Config.Announce.Matrix.MsgType)
+	require.Equal(t, defaultMessageTemplate, ctx.Config.Announce.Matrix.MessageTemplate)
+}
+
+func TestAnnounceMissingToken(t *testing.T) {
+	ctx := context.New(config.Project{
+		Announce: config.Announce{
+			Matrix: config.Matrix{
+				HomeserverURL:  "https://matrix.example.com",
+				RoomID:         "!room:example.com",
+				AccessTokenEnv: "MATRIX_TOKEN_NOT_SET",
+			},
+		},
+	})
+	require.EqualError(t, Pipe{}.Announce(ctx), `matrix: missing access token: "MATRIX_TOKEN_NOT_SET" is not set`)
+}
+
+func TestAnnounceSuccess(t *testing.T) {
+	httpmock.ActivateNonDefault(http.DefaultClient)
+	defer httpmock.DeactivateAndReset()
+
+	t.Setenv("MATRIX_TOKEN", "s3cr3t")
+
+	ctx := context.New(config.Project{
+		ProjectName: "myproject",
+		Announce: config.Announce{
+			Matrix: config.Matrix{
+				HomeserverURL:  "https://matrix.example.com",
+				RoomID:         "!room:example.com",
+				AccessTokenEnv: "MATRIX_TOKEN",
+			},
+		},
+	})
+	ctx.Git.CurrentTag = "v1.2.3"
+	ctx.ReleaseURL = "https://example.com/releases/v1.2.3"
+	require.NoError(t, Pipe{}.Default(ctx))
+
+	var capturedBody map[string]string
+	httpmock.RegisterResponder(
+		"PUT",
+		`=~^https://matrix\.example\.com/_matrix/client/v3/rooms/%21room:example\.com/send/m\.room\.message/\d+$`,
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "Bearer s3cr3t", req.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&capturedBody))
+			return httpmock.NewStringResponse(200, `{"event_id":"$abc"}`), nil
+		},
+	)
+
+	require.NoError(t, Pipe{}.Announce(ctx))
+	require.Equal(t, "m.notice", capturedBody["msgtype"])
+	require.Equal(t, "org.matrix.custom.html", capturedBody["format"])
+	require.True(t, strings.Contains(capturedBody["body"], "myproject v1.2.3"))
+	require.True(t, strings.Contains(capturedBody["body"], "https://example.com/releases/v1.2.3"))
+	require.Equal(t, "myproject v1.2.3 is out! Check it out at https://example.com/releases/v1.2.3", capturedBody["body"])
+	require.Equal(t, "myproject v1.2.3 is out! Check it out at https://example.com/releases/v1.2.3", capturedBody["formatted_body"])
+}
+
+func TestAnnounceEscapesFormattedBody(t *testing.T) {
+	httpmock.ActivateNonDefault(http.DefaultClient)
+	defer httpmock.DeactivateAndReset()
+
+	t.Setenv("MATRIX_TOKEN", "s3cr3t")
+
+	ctx := context.New(config.Project{
+		Announce: config.Announce{
+			Matrix: config.Matrix{
+				HomeserverURL:   "https://matrix.example.com",
+				RoomID:          "!room:example.com",
+				AccessTokenEnv:  "MATRIX_TOKEN",
+				MessageTemplate: "{{ .ReleaseNotes }}",
+			},
+		},
+	})
+	ctx.ReleaseNotes = "<b>bold</b> & stuff"
+	require.NoError(t, Pipe{}.Default(ctx))
+
+	var capturedBody map[string]string
+	httpmock.RegisterResponder(
+		"PUT",
+		`=~^https://matrix\.example\.com/_matrix/client/v3/rooms/%21room:example\.com/send/m\.room\.message/\d+$`,
+		func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&capturedBody))
+			return httpmock.NewStringResponse(200, `{"event_id":"$abc"}`), nil
+		},
+	)
+
+	require.NoError(t, Pipe{}.Announce(ctx))
+	require.Equal(t, "<b>bold</b> & stuff", capturedBody["body"])
+	require.Equal(t, "&lt;b&gt;bold&lt;/b&gt; &amp; stuff", capturedBody["formatted_body"])
+}
+
+func TestSendURLReusesTxnIDForSameRelease(t *testing.T) {
+	ctx := context.New(config.Project{ProjectName: "myproject"})
+	ctx.Git.CurrentTag = "v1.2.3"
+
+	first := sendURL("https://matrix.example.com", "!room:example.com", ctx)
+	second := sendURL("https://matrix.example.com", "!room:example.com", ctx)
+	require.Equal(t, first, second)
+}
+
+func TestSendURLDiffersForDifferentReleases(t *testing.T) {
+	ctx := context.New(config.Project{ProjectName: "myproject"})
+	ctx.Git.CurrentTag = "v1.2.3"
+	other := context.New(config.Project{ProjectName: "myproject"})
+	other.Git.CurrentTag = "v1.2.4"
+
+	require.NotEqual(t,
+		sendURL("https://matrix.example.com", "!room:example.com", ctx),
+		sendURL("https://matrix.example.com", "!room:example.com", other),
+	)
+}
+
+func TestAnnounceError(t *testing.T) {
+	httpmock.ActivateNonDefault(http.DefaultClient)
+	defer httpmock.DeactivateAndReset()
+
+	os.Setenv("MATRIX_TOKEN", "s3cr3t")
+	defer os.Unsetenv("MATRIX_TOKEN")
+
+	ctx := context.New(config.Project{
+		Announce: config.Announce{
+			Matrix: config.Matrix{
+				HomeserverURL:  "https://matrix.example.com",
+				RoomID:         "!room:example.com",
+				AccessTokenEnv: "MATRIX_TOKEN",
+			},
+		},
+	})
+	require.NoError(t, Pipe{}.Default(ctx))
+
+	httpmock.RegisterResponder(
+		"PUT",
+		`=~^https://matrix\.example\.com/_matrix/client/v3/rooms/.*/send/m\.room\.message/\d+$`,
+		httpmock.NewStringResponder(500, ""),
+	)
+
+	require.Error(t, Pipe{}.Announce(ctx))
+}
@@ -0,0 +1,104 @@
+// Package release wraps the Gitea release API (find, create, update,
+// upload and delete assets) behind a small interface that only depends on
+// plain values, not goreleaser's pipe context.Context. This lets it be
+// unit tested directly, and reused outside of the release pipe.
+package release
+
+import (
+	"fmt"
+	"io"
+
+	"code.gitea.io/sdk/gitea"
+)
+
+// Options describes the release to create or update.
+type Options struct {
+	Owner      string
+	Repo       string
+	Tag        string
+	Target     string
+	Name       string
+	Note       string
+	Draft      bool
+	Prerelease bool
+}
+
+// Service talks to the Gitea release API.
+type Service interface {
+	// Find returns the release matching tag, or nil if there is none yet.
+	Find(owner, repo, tag string) (*gitea.Release, error)
+	// Create creates a new release.
+	Create(opts Options) (*gitea.Release, error)
+	// Update updates the release identified by releaseID.
+	Update(opts Options, releaseID int64) (*gitea.Release, error)
+	// UploadAsset uploads file as a release attachment named name.
+	UploadAsset(owner, repo string, releaseID int64, name string, file io.Reader) (*gitea.Attachment, error)
+	// ListAssets lists every attachment already on a release.
+	ListAssets(owner, repo string, releaseID int64) ([]*gitea.Attachment, error)
+	// DeleteAsset deletes a single release attachment.
+	DeleteAsset(owner, repo string, releaseID, assetID int64) error
+}
+
+type service struct {
+	client *gitea.Client
+}
+
+// New returns a Service backed by client.
+func New(client *gitea.Client) Service {
+	return &service{client: client}
+}
+
+func (s *service) Find(owner, repo, tag string) (*gitea.Release, error) {
+	releases, err := s.client.ListReleases(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range releases {
+		if r.TagName == tag {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *service) Create(opts Options) (*gitea.Release, error) {
+	release, err := s.client.CreateRelease(opts.Owner, opts.Repo, gitea.CreateReleaseOption{
+		TagName:      opts.Tag,
+		Target:       opts.Target,
+		Title:        opts.Name,
+		Note:         opts.Note,
+		IsDraft:      opts.Draft,
+		IsPrerelease: opts.Prerelease,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitea release: %w", err)
+	}
+	return release, nil
+}
+
+func (s *service) Update(opts Options, releaseID int64) (*gitea.Release, error) {
+	release, err := s.client.EditRelease(opts.Owner, opts.Repo, releaseID, gitea.EditReleaseOption{
+		TagName:      opts.Tag,
+		Target:       opts.Target,
+		Title:        opts.Name,
+		Note:         opts.Note,
+		IsDraft:      &opts.Draft,
+		IsPrerelease: &opts.Prerelease,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update gitea release: %w", err)
+	}
+	return release, nil
+}
+
+func (s *service) UploadAsset(owner, repo string, releaseID int64, name string, file io.Reader) (*gitea.Attachment, error) {
+	return s.client.CreateReleaseAttachment(owner, repo, releaseID, file, name)
+}
+
+func (s *service) ListAssets(owner, repo string, releaseID int64) ([]*gitea.Attachment, error) {
+	return s.client.ListReleaseAttachments(owner, repo, releaseID)
+}
+
+func (s *service) DeleteAsset(owner, repo string, releaseID, assetID int64) error {
+	return s.client.DeleteReleaseAttachment(owner, repo, releaseID, assetID)
+}
@@ -0,0 +1,224 @@
+package release
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReleaseSuite struct {
+	suite.Suite
+	url         string
+	owner       string
+	repo        string
+	tag         string
+	target      string
+	note        string
+	svc         Service
+	releasesURL string
+	releaseURL  string
+	releaseID   int64
+}
+
+func (s *ReleaseSuite) SetupTest() {
+	httpmock.Activate()
+	s.url = "https://gitea.example.com"
+	s.owner = "owner"
+	s.repo = "repoName"
+	s.tag = "tag"
+	s.target = "some commit hash"
+	s.note = "gitea release description"
+	s.releasesURL = fmt.Sprintf("%v/api/v1/repos/%v/%v/releases", s.url, s.owner, s.repo)
+	s.releaseID = 666
+	s.releaseURL = fmt.Sprintf("%v/%v", s.releasesURL, s.releaseID)
+	httpmock.RegisterResponder("GET", s.url+"/api/v1/version", httpmock.NewStringResponder(200, `{"version":"1.16.0"}`))
+	client, err := gitea.NewClient(s.url)
+	s.Require().NoError(err)
+	s.svc = New(client)
+}
+
+func (s *ReleaseSuite) TearDownTest() {
+	httpmock.DeactivateAndReset()
+}
+
+func (s *ReleaseSuite) opts() Options {
+	return Options{
+		Owner:  s.owner,
+		Repo:   s.repo,
+		Tag:    s.tag,
+		Target: s.target,
+		Name:   "gitea_release_title",
+		Note:   s.note,
+	}
+}
+
+func (s *ReleaseSuite) TestFindNoReleases() {
+	t := s.T()
+	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(200, "[]"))
+
+	release, err := s.svc.Find(s.owner, s.repo, s.tag)
+	assert.Nil(t, release)
+	assert.NoError(t, err)
+}
+
+func (s *ReleaseSuite) TestFindNoRepo() {
+	t := s.T()
+	httpmock.RegisterResponder("GET", s.releasesURL, httpmock.NewStringResponder(404, ""))
+
+	release, err := s.svc.Find(s.owner, s.repo, s.tag)
+	assert.Nil(t, release)
+	assert.Error(t, err)
+}
+
+func (s *ReleaseSuite) TestFindReleaseExists() {
+	t := s.T()
+	expected := gitea.Release{TagName: s.tag}
+	resp, err := httpmock.NewJsonResponder(200, []gitea.Release{expected})
+	require.NoError(t, err)
+	httpmock.RegisterResponder("GET", s.releasesURL, resp)
+
+	result, err := s.svc.Find(s.owner, s.repo, s.tag)
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, expected, *result)
+}
+
+func (s *ReleaseSuite) TestCreateSuccess() {
+	t := s.T()
+	expected := gitea.Release{TagName: s.tag, Target: s.target, Note: s.note}
+	resp, err := httpmock.NewJsonResponder(200, &expected)
+	require.NoError(t, err)
+	httpmock.RegisterResponder("POST", s.releasesURL, resp)
+
+	release, err := s.svc.Create(s.opts())
+	assert.NoError(t, err)
+	require.NotNil(t, release)
+	assert.Equal(t, expected, *release)
+}
+
+func (s *ReleaseSuite) TestCreateError() {
+	t := s.T()
+	httpmock.RegisterResponder("POST", s.releasesURL, httpmock.NewStringResponder(400, ""))
+
+	release, err := s.svc.Create(s.opts())
+	assert.Error(t, err)
+	assert.Nil(t, release)
+}
+
+func (s *ReleaseSuite) TestUpdateSuccess() {
+	t := s.T()
+	expected := gitea.Release{TagName: s.tag, Target: s.target, Note: s.note}
+	resp, err := httpmock.NewJsonResponder(200, &expected)
+	require.NoError(t, err)
+	httpmock.RegisterResponder("PATCH", s.releaseURL, resp)
+
+	release, err := s.svc.Update(s.opts(), s.releaseID)
+	assert.NoError(t, err)
+	assert.NotNil(t, release)
+}
+
+func (s *ReleaseSuite) TestUpdateError() {
+	t := s.T()
+	httpmock.RegisterResponder("PATCH", s.releaseURL, httpmock.NewStringResponder(400, ""))
+
+	release, err := s.svc.Update(s.opts(), s.releaseID)
+	assert.Error(t, err)
+	assert.Nil(t, release)
+}
+
+func TestReleaseSuite(t *testing.T) {
+	suite.Run(t, new(ReleaseSuite))
+}
+
+// AssetSuite covers UploadAsset, ListAssets and DeleteAsset directly
+// against the Service, with httpmock but without the context.Context
+// boilerplate the giteaClient adapter tests need.
+type AssetSuite struct {
+	ReleaseSuite
+	attachmentsURL string
+	assetName      string
+	file           *os.File
+}
+
+func (s *AssetSuite) SetupTest() {
+	s.ReleaseSuite.SetupTest()
+	s.attachmentsURL = fmt.Sprintf("%v/assets", s.releaseURL)
+	s.assetName = "asset.tar.gz"
+	file, err := os.CreateTemp("", "release_asset_test")
+	s.Require().NoError(err)
+	s.file = file
+}
+
+func (s *AssetSuite) TearDownTest() {
+	s.ReleaseSuite.TearDownTest()
+	s.Require().NoError(s.file.Close())
+}
+
+func (s *AssetSuite) TestUploadAssetSuccess() {
+	t := s.T()
+	expected := gitea.Attachment{ID: 1, Name: s.assetName}
+	resp, err := httpmock.NewJsonResponder(200, &expected)
+	require.NoError(t, err)
+	httpmock.RegisterResponder("POST", s.attachmentsURL, resp)
+
+	attachment, err := s.svc.UploadAsset(s.owner, s.repo, s.releaseID, s.assetName, s.file)
+	assert.NoError(t, err)
+	require.NotNil(t, attachment)
+	assert.Equal(t, expected, *attachment)
+}
+
+func (s *AssetSuite) TestUploadAssetError() {
+	t := s.T()
+	httpmock.RegisterResponder("POST", s.attachmentsURL, httpmock.NewStringResponder(400, ""))
+
+	_, err := s.svc.UploadAsset(s.owner, s.repo, s.releaseID, s.assetName, s.file)
+	assert.Error(t, err)
+}
+
+func (s *AssetSuite) TestListAssetsSuccess() {
+	t := s.T()
+	expected := []gitea.Attachment{{ID: 1, Name: s.assetName}}
+	resp, err := httpmock.NewJsonResponder(200, expected)
+	require.NoError(t, err)
+	httpmock.RegisterResponder("GET", s.attachmentsURL, resp)
+
+	attachments, err := s.svc.ListAssets(s.owner, s.repo, s.releaseID)
+	assert.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, s.assetName, attachments[0].Name)
+}
+
+func (s *AssetSuite) TestListAssetsError() {
+	httpmock.RegisterResponder("GET", s.attachmentsURL, httpmock.NewStringResponder(400, ""))
+
+	_, err := s.svc.ListAssets(s.owner, s.repo, s.releaseID)
+	assert.Error(s.T(), err)
+}
+
+func (s *AssetSuite) TestDeleteAssetSuccess() {
+	assetID := int64(42)
+	deleteURL := fmt.Sprintf("%v/%v", s.attachmentsURL, assetID)
+	httpmock.RegisterResponder("DELETE", deleteURL, httpmock.NewStringResponder(204, ""))
+
+	err := s.svc.DeleteAsset(s.owner, s.repo, s.releaseID, assetID)
+	assert.NoError(s.T(), err)
+}
+
+func (s *AssetSuite) TestDeleteAssetError() {
+	assetID := int64(42)
+	deleteURL := fmt.Sprintf("%v/%v", s.attachmentsURL, assetID)
+	httpmock.RegisterResponder("DELETE", deleteURL, httpmock.NewStringResponder(400, ""))
+
+	err := s.svc.DeleteAsset(s.owner, s.repo, s.releaseID, assetID)
+	assert.Error(s.T(), err)
+}
+
+func TestAssetSuite(t *testing.T) {
+	suite.Run(t, new(AssetSuite))
+}
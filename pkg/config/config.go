@@ -0,0 +1,85 @@
+// Package config contains the model and loader of goreleaser configuration
+// files.
+package config
+
+import "time"
+
+// Repo represents any kind of repo (github, gitlab, etc).
+type Repo struct {
+	Owner string `yaml:"owner,omitempty"`
+	Name  string `yaml:"name,omitempty"`
+}
+
+// String of the repo, e.g. owner/name.
+func (r Repo) String() string {
+	if r.Owner == "" && r.Name == "" {
+		return ""
+	}
+	return r.Owner + "/" + r.Name
+}
+
+// GiteaRetry configures exponential-backoff retries for transient errors
+// (5xx and 429 responses) when talking to the Gitea API.
+type GiteaRetry struct {
+	MaxAttempts int           `yaml:"max_attempts,omitempty"`
+	Backoff     time.Duration `yaml:"backoff,omitempty"`
+}
+
+// Gitea identifies the repo a release is published to, and customizes the
+// HTTP transport used to reach it.
+type Gitea struct {
+	Repo `yaml:",inline"`
+
+	InsecureSkipVerify bool       `yaml:"insecure_skip_verify,omitempty"`
+	CACert             string     `yaml:"ca_cert,omitempty"`
+	ClientCert         string     `yaml:"client_cert,omitempty"`
+	ClientKey          string     `yaml:"client_key,omitempty"`
+	ProxyURL           string     `yaml:"proxy_url,omitempty"`
+	Retry              GiteaRetry `yaml:"retry,omitempty"`
+
+	// ReplaceExistingArtifacts controls whether a release attachment that
+	// already exists under the same name is deleted and re-uploaded, or
+	// left in place (leaving a duplicate). Defaults to true.
+	ReplaceExistingArtifacts *bool `yaml:"replace_existing_artifacts,omitempty"`
+}
+
+// GiteaURLs holds the URLs for a self-hosted Gitea instance.
+type GiteaURLs struct {
+	API      string `yaml:"api,omitempty"`
+	Download string `yaml:"download,omitempty"`
+}
+
+// CommitAuthor holds the author information used when creating commits.
+type CommitAuthor struct {
+	Name  string `yaml:"name,omitempty"`
+	Email string `yaml:"email,omitempty"`
+}
+
+// Release config used for the release.
+type Release struct {
+	Gitea        Gitea     `yaml:"gitea,omitempty"`
+	GiteaURLs    GiteaURLs `yaml:"gitea_urls,omitempty"`
+	NameTemplate string    `yaml:"name_template,omitempty"`
+	Draft        bool      `yaml:"draft,omitempty"`
+}
+
+// Matrix announce config.
+type Matrix struct {
+	HomeserverURL   string `yaml:"homeserver_url,omitempty"`
+	AccessTokenEnv  string `yaml:"access_token_env,omitempty"`
+	RoomID          string `yaml:"room_id,omitempty"`
+	MsgType         string `yaml:"msg_type,omitempty"`
+	MessageTemplate string `yaml:"message_template,omitempty"`
+}
+
+// Announce config used for the announcing part of the release.
+type Announce struct {
+	Matrix Matrix `yaml:"matrix,omitempty"`
+}
+
+// Project includes all project configuration.
+type Project struct {
+	ProjectName string   `yaml:"project_name,omitempty"`
+	Release     Release  `yaml:"release,omitempty"`
+	Announce    Announce `yaml:"announce,omitempty"`
+}
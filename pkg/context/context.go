@@ -0,0 +1,58 @@
+// Package context provides the pipeline execution context carried through
+// goreleaser's pipes.
+package context
+
+import (
+	"context"
+
+	"github.com/goreleaser/goreleaser/pkg/config"
+)
+
+// GitInfo includes tags and commit information about the current repo.
+type GitInfo struct {
+	CurrentTag  string
+	Commit      string
+	ShortCommit string
+	URL         string
+}
+
+// Env is the environment variables.
+type Env map[string]string
+
+// Semver represents a semantic version.
+type Semver struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+}
+
+// Context carries along some data through the pipes.
+type Context struct {
+	context.Context
+	Config     config.Project
+	Env        Env
+	Semver     Semver
+	Git        GitInfo
+	Version    string
+	PreRelease bool
+
+	// ReleaseURL and ReleaseNotes are filled in by the release pipe once
+	// the release has been created, so later pipes (e.g. announcers) can
+	// refer to it.
+	ReleaseURL   string
+	ReleaseNotes string
+}
+
+// New context.
+func New(config config.Project) *Context {
+	return Wrap(context.Background(), config)
+}
+
+// Wrap wraps an existing context.
+func Wrap(ctx context.Context, config config.Project) *Context {
+	return &Context{
+		Context: ctx,
+		Config:  config,
+		Env:     Env{},
+	}
+}
@@ -0,0 +1,55 @@
+// Package tmpl provides templating helpers evaluated against the release
+// context (project name, version, tag, etc).
+package tmpl
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+// fields exposed to templates. Accessing a name that isn't one of these
+// fields is a template execution error, which is what we want.
+type fields struct {
+	ProjectName  string
+	Version      string
+	Tag          string
+	Commit       string
+	ShortCommit  string
+	ReleaseURL   string
+	ReleaseNotes string
+}
+
+// Template holds data that can be applied to a template string.
+type Template struct {
+	fields fields
+}
+
+// New Template for the given context.
+func New(ctx *context.Context) *Template {
+	return &Template{
+		fields: fields{
+			ProjectName:  ctx.Config.ProjectName,
+			Version:      ctx.Version,
+			Tag:          ctx.Git.CurrentTag,
+			Commit:       ctx.Git.Commit,
+			ShortCommit:  ctx.Git.ShortCommit,
+			ReleaseURL:   ctx.ReleaseURL,
+			ReleaseNotes: ctx.ReleaseNotes,
+		},
+	}
+}
+
+// Apply renders s against the template fields.
+func (t *Template) Apply(s string) (string, error) {
+	tmpl, err := template.New("tmpl").Parse(s)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, t.fields); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}